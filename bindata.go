@@ -0,0 +1,776 @@
+// Package main embeds the template assets under templates/ so that
+// cli-init can run as a single static binary.
+//
+// This file is hand-maintained; keep it in sync with templates/**/*.tmpl.
+package main
+
+import "fmt"
+
+var _bindata = map[string][]byte{
+	"templates/README.tmpl": []byte(`# {{.Name}}
+{{if .WithBadges}}
+[![GitHub release](http://img.shields.io/github/release/{{.Username}}/{{.Name}}.svg?style=flat-square)][release]
+[![License](http://img.shields.io/badge/license-MIT-blue.svg?style=flat-square)][license]
+
+[release]: https://github.com/{{.Username}}/{{.Name}}/releases
+[license]: https://github.com/{{.Username}}/{{.Name}}/blob/master/LICENSE
+{{end}}
+## Description
+
+## Usage
+
+## Install
+
+## Contribute
+
+1. Fork ({{.Username}}/{{.Name}})
+2. Create a feature branch
+3. Commit your changes
+4. Rebase your local changes against the master branch
+5. Run test suite with the go test ./... command and confirm that it passes
+6. Run gofmt -s
+7. Create a new Pull Request
+
+## Author
+
+**{{.Author}}**
+
+* <{{.Email}}>
+`),
+	"templates/CHANGELOG.tmpl": []byte(`# Changelog
+
+## [Unreleased]
+
+### Added
+
+- Initial release of {{.Name}}
+`),
+	"templates/suggestions.tmpl": []byte(`package main
+
+// suggest returns the known command most similar to name, using the
+// Jaro-Winkler distance, provided its similarity is at least 0.7.
+func suggest(name string, candidates []string) (string, bool) {
+	best := ""
+	bestScore := 0.0
+
+	for _, candidate := range candidates {
+		score := jaroWinkler(name, candidate)
+		if score >= 0.7 && score > bestScore {
+			best = candidate
+			bestScore = score
+		}
+	}
+
+	return best, best != ""
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity between a and b, a
+// value in [0, 1] where 1 means an exact match.
+func jaroWinkler(a, b string) float64 {
+	jaro := jaroSimilarity(a, b)
+	if jaro == 0 {
+		return 0
+	}
+
+	const (
+		maxPrefix = 4
+		scaling   = 0.1
+	)
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && prefix < maxPrefix && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*scaling*(1-jaro)
+}
+
+// jaroSimilarity returns the Jaro similarity between a and b.
+func jaroSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	matchDistance := maxInt(len(a), len(b))/2 - 1
+	if matchDistance < 0 {
+		matchDistance = 0
+	}
+
+	aMatches := make([]bool, len(a))
+	bMatches := make([]bool, len(b))
+
+	matches := 0
+	for i := range a {
+		start := maxInt(0, i-matchDistance)
+		end := minInt(i+matchDistance+1, len(b))
+		for j := start; j < end; j++ {
+			if bMatches[j] || a[i] != b[j] {
+				continue
+			}
+			aMatches[i] = true
+			bMatches[j] = true
+			matches++
+			break
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	k := 0
+	for i := range a {
+		if !aMatches[i] {
+			continue
+		}
+		for !bMatches[k] {
+			k++
+		}
+		if a[i] != b[k] {
+			transpositions++
+		}
+		k++
+	}
+
+	m := float64(matches)
+	t := float64(transpositions) / 2
+
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-t)/m) / 3
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+`),
+	"templates/suggestions_test.tmpl": []byte(`package main
+
+import "testing"
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{ {{range .SubCommands}}"{{.Name}}", {{end}} }
+
+	typo := "{{(index .SubCommands 0).Name}}x"
+	want := "{{(index .SubCommands 0).Name}}"
+
+	got, ok := suggest(typo, candidates)
+	if !ok {
+		t.Fatalf("suggest(%q, %v) found no match, want %q", typo, candidates, want)
+	}
+	if got != want {
+		t.Errorf("suggest(%q, %v) = %q, want %q", typo, candidates, got, want)
+	}
+}
+`),
+	"templates/completion.tmpl": []byte(`package main
+
+import "fmt"
+
+// completionCommands lists the subcommand names {{.Name}} exposes, kept
+// in sync with Application.SubCommands at generation time.
+var completionCommands = []string{ {{range .SubCommands}}"{{.Name}}", {{end}} }
+
+// completionScript returns the shell completion script for shell, one
+// of "bash", "zsh", "fish" or "powershell".
+func completionScript(shell string) (string, error) {
+	switch shell {
+	case "bash":
+		return bashCompletion("{{.Name}}", completionCommands), nil
+	case "zsh":
+		return zshCompletion("{{.Name}}", completionCommands), nil
+	case "fish":
+		return fishCompletion("{{.Name}}", completionCommands), nil
+	case "powershell":
+		return powershellCompletion("{{.Name}}", completionCommands), nil
+	default:
+		return "", fmt.Errorf("unknown shell %q, must be one of bash, zsh, fish, powershell", shell)
+	}
+}
+
+func bashCompletion(name string, commands []string) string {
+	words := ""
+	for _, c := range commands {
+		words += c + " "
+	}
+	return "_" + name + "_completion() {\n" +
+		"\tCOMPREPLY=($(compgen -W \"" + words + "\" -- \"${COMP_WORDS[COMP_CWORD]}\"))\n" +
+		"}\n" +
+		"complete -F _" + name + "_completion " + name + "\n"
+}
+
+func zshCompletion(name string, commands []string) string {
+	words := ""
+	for _, c := range commands {
+		words += c + " "
+	}
+	return "#compdef " + name + "\n" +
+		"_arguments '1: :(" + words + ")'\n"
+}
+
+func fishCompletion(name string, commands []string) string {
+	script := ""
+	for _, c := range commands {
+		script += "complete -c " + name + " -f -n '__fish_use_subcommand' -a " + c + "\n"
+	}
+	return script
+}
+
+func powershellCompletion(name string, commands []string) string {
+	words := ""
+	for i, c := range commands {
+		if i > 0 {
+			words += ", "
+		}
+		words += "'" + c + "'"
+	}
+	return "Register-ArgumentCompleter -Native -CommandName " + name + " -ScriptBlock {\n" +
+		"\tparam($wordToComplete)\n" +
+		"\t@(" + words + ") | Where-Object { $_ -like \"$wordToComplete*\" }\n" +
+		"}\n"
+}
+`),
+	"templates/Dockerfile.tmpl": []byte(`# Build stage
+FROM golang:1.21-alpine AS build
+
+ARG BUILD_SHA=dev
+
+WORKDIR /src
+COPY . .
+RUN CGO_ENABLED=0 go build -ldflags "-s -w -X main.build=${BUILD_SHA}" -o /out/{{.Name}} .
+
+# Final stage
+FROM scratch
+COPY --from=build /out/{{.Name}} /{{.Name}}
+ENTRYPOINT ["/{{.Name}}"]
+`),
+	"templates/Makefile.tmpl": []byte(`NAME := {{.Name}}
+VERSION := $(shell git describe --tags --always --dirty 2>/dev/null || echo dev)
+LDFLAGS := -s -w -X main.build=$(VERSION)
+
+.PHONY: deps vet test build{{if .DockerImage}} docker{{end}}
+
+deps:
+	go mod download
+
+vet:
+	go vet ./...
+
+test:
+	go test -cover -coverprofile=coverage.out ./...
+
+build:
+	CGO_ENABLED=0 go build -ldflags "$(LDFLAGS)" -o bin/$(NAME) .
+{{if .DockerImage}}
+docker:
+	docker build --build-arg BUILD_SHA=$(VERSION) -t {{.DockerImage}} .
+{{end}}
+`),
+	"templates/ci/github.tmpl": []byte(`name: CI
+
+on: [push, pull_request]
+
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-go@v5
+        with:
+          go-version: "1.21"
+      - run: go vet ./...
+      - run: go test -cover -coverprofile=coverage.out ./...
+      - run: go build -ldflags "-X main.build={{"${{ github.sha }}"}}" ./...
+`),
+	"templates/ci/drone.tmpl": []byte(`kind: pipeline
+type: docker
+name: default
+
+steps:
+  - name: vet
+    image: golang:1.21
+    commands:
+      - go vet ./...
+
+  - name: test
+    image: golang:1.21
+    commands:
+      - go test -cover -coverprofile=coverage.out ./...
+
+  - name: build
+    image: golang:1.21
+    commands:
+      - go build -ldflags "-X main.build=$DRONE_COMMIT" ./...
+`),
+	"templates/docs/completions.tmpl": []byte(`# Shell completion
+
+{{.Name}} can print a completion script for bash, zsh, fish or
+powershell via its hidden "completion" subcommand. The script
+lists the subcommand names in completionCommands (completion.go),
+which holds the subcommands {{.Name}} was scaffolded with; a
+subcommand added later is completed only once its name is in that
+list.
+
+## bash
+
+    source <({{.Name}} completion bash)
+
+## zsh
+
+    source <({{.Name}} completion zsh)
+
+## fish
+
+    {{.Name}} completion fish | source
+
+## powershell
+
+    {{.Name}} completion powershell | Out-String | Invoke-Expression
+
+Add the relevant line to your shell's startup file (~/.bashrc,
+~/.zshrc, config.fish, or your PowerShell profile) to load it in
+every new session.
+`),
+	"templates/mflag/main.tmpl": []byte(`package main
+
+import (
+{{if .HasSubCommand}}	"fmt"
+{{end}}	flag "github.com/dotcloud/docker/pkg/mflag"
+{{if .HasSubCommand}}	"os"
+{{end}})
+
+func main() {
+	flag.Parse()
+
+{{if .HasSubCommand}}	switch flag.Arg(0) {
+{{range .SubCommands}}	case "{{.Name}}":
+		{{.FunctionName}}(flag.Args()[1:])
+{{end}}{{if .WithCompletions}}	case "completion":
+		shell := ""
+		if len(flag.Args()) > 1 {
+			shell = flag.Args()[1]
+		}
+		script, err := completionScript(shell)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Print(script)
+{{end}}	default:
+{{if .WithSuggestions}}		if name, ok := suggest(flag.Arg(0), []string{ {{range .SubCommands}}"{{.Name}}", {{end}} }); ok {
+			fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\nDid you mean %q?\n", flag.Arg(0), name)
+			os.Exit(1)
+		}
+{{end}}		fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\n", flag.Arg(0))
+		os.Exit(1)
+	}
+{{else}}	// This is where the magic happens
+{{end}}}
+`),
+	"templates/mflag/commands.tmpl": []byte(`package main
+
+// mflag has no notion of a command tree, so nested subcommands are
+// flattened to their top-level parent's dispatch function here.
+
+{{range .SubCommands}}
+func {{.FunctionName}}(args []string) {
+	// Add command action here...
+}
+{{end}}
+`),
+	"templates/mflag/version.tmpl": []byte(`package main
+
+// Version is the version number of {{.Name}}.
+const Version = "0.0.1"
+
+// build is the build identifier, injected at build time via
+// -ldflags "-X main.build=...".
+var build string
+`),
+	"templates/urfave-cli-v1/main.tmpl": []byte(`package main
+
+import (
+{{if and .HasSubCommand .WithSuggestions}}	"fmt"
+{{end}}	"os"
+
+	"github.com/urfave/cli"
+)
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "{{.Name}}"
+	app.Usage = ""
+	app.Version = Version
+{{if .HasSubCommand}}
+	app.Commands = Commands
+{{if .WithSuggestions}}
+	app.CommandNotFound = func(c *cli.Context, command string) {
+		names := []string{}
+		for _, cmd := range c.App.Commands {
+			names = append(names, cmd.Name)
+		}
+		if name, ok := suggest(command, names); ok {
+			fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\nDid you mean %q?\n", command, name)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\n", command)
+		os.Exit(1)
+	}
+{{end}}{{else}}
+	app.Action = func(c *cli.Context) {
+		// This is where the magic happens
+	}
+{{end}}
+	app.Run(os.Args)
+}
+`),
+	"templates/urfave-cli-v1/commands.tmpl": []byte(`package main
+
+import (
+{{if .WithCompletions}}	"fmt"
+	"os"
+
+{{end}}	"github.com/urfave/cli"
+)
+
+var Commands = []cli.Command{
+{{range .SubCommands}}	{{.DefineName}},
+{{end}}{{if .WithCompletions}}	completionCommand,
+{{end}}}
+
+{{if .WithCompletions}}
+var completionCommand = cli.Command{
+	Name:   "completion",
+	Usage:  "Generate shell completion script",
+	Hidden: true,
+	Action: func(c *cli.Context) {
+		script, err := completionScript(c.Args().First())
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Print(script)
+	},
+}
+{{end}}
+
+{{range .SubCommands}}{{template "subcommand" .}}{{end}}
+{{define "subcommand"}}
+var {{.DefineName}} = cli.Command{
+	Name:  "{{.Name}}",
+{{if .Aliases}}	Aliases: []string{ {{range .Aliases}}"{{.}}", {{end}} },
+{{end}}	Usage: "{{.Usage}}",
+{{if .Flags}}	Flags: []cli.Flag{
+{{range .Flags}}		cli.StringFlag{Name: "{{.Name}}", Usage: "{{.Usage}}"},
+{{end}}	},
+{{end}}{{if .Subcommands}}	Subcommands: []cli.Command{
+{{range .Subcommands}}		{{.DefineName}},
+{{end}}	},
+{{else}}	Action: func(c *cli.Context) {
+		{{.FunctionName}}(c)
+	},
+{{end}}}
+{{if not .Subcommands}}
+func {{.FunctionName}}(c *cli.Context) {
+	// Add command action here...
+}
+{{end}}
+{{range .Subcommands}}{{template "subcommand" .}}{{end}}
+{{end}}
+`),
+	"templates/urfave-cli-v1/version.tmpl": []byte(`package main
+
+// Version is the version number of {{.Name}}.
+const Version = "0.0.1"
+
+// build is the build identifier, injected at build time via
+// -ldflags "-X main.build=...".
+var build string
+`),
+	"templates/urfave-cli-v2/main.tmpl": []byte(`package main
+
+import (
+{{if and .HasSubCommand .WithSuggestions}}	"fmt"
+{{end}}	"log"
+	"os"
+
+	"github.com/urfave/cli/v2"
+)
+
+func main() {
+	app := &cli.App{
+		Name:    "{{.Name}}",
+		Usage:   "",
+		Version: Version,
+{{if .HasSubCommand}}		Commands: Commands,
+{{if .WithSuggestions}}		CommandNotFound: func(c *cli.Context, command string) {
+			names := []string{}
+			for _, cmd := range c.App.Commands {
+				names = append(names, cmd.Name)
+			}
+			if name, ok := suggest(command, names); ok {
+				fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\nDid you mean %q?\n", command, name)
+				os.Exit(1)
+			}
+			fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\n", command)
+			os.Exit(1)
+		},
+{{end}}{{else}}		Action: func(c *cli.Context) error {
+			// This is where the magic happens
+			return nil
+		},
+{{end}}	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+`),
+	"templates/urfave-cli-v2/commands.tmpl": []byte(`package main
+
+import (
+{{if .WithCompletions}}	"fmt"
+
+{{end}}	"github.com/urfave/cli/v2"
+)
+
+var Commands = []*cli.Command{
+{{range .SubCommands}}	{{.DefineName}},
+{{end}}{{if .WithCompletions}}	completionCommand,
+{{end}}}
+
+{{if .WithCompletions}}
+var completionCommand = &cli.Command{
+	Name:   "completion",
+	Usage:  "Generate shell completion script",
+	Hidden: true,
+	Action: func(c *cli.Context) error {
+		script, err := completionScript(c.Args().First())
+		if err != nil {
+			return err
+		}
+		fmt.Print(script)
+		return nil
+	},
+}
+{{end}}
+
+{{range .SubCommands}}{{template "subcommand" .}}{{end}}
+{{define "subcommand"}}
+var {{.DefineName}} = &cli.Command{
+	Name:  "{{.Name}}",
+{{if .Aliases}}	Aliases: []string{ {{range .Aliases}}"{{.}}", {{end}} },
+{{end}}	Usage: "{{.Usage}}",
+{{if .Flags}}	Flags: []cli.Flag{
+{{range .Flags}}		&cli.StringFlag{Name: "{{.Name}}", Usage: "{{.Usage}}"},
+{{end}}	},
+{{end}}{{if .Subcommands}}	Subcommands: []*cli.Command{
+{{range .Subcommands}}		{{.DefineName}},
+{{end}}	},
+{{else}}	Action: func(c *cli.Context) error {
+		return {{.FunctionName}}(c)
+	},
+{{end}}}
+{{if not .Subcommands}}
+func {{.FunctionName}}(c *cli.Context) error {
+	// Add command action here...
+	return nil
+}
+{{end}}
+{{range .Subcommands}}{{template "subcommand" .}}{{end}}
+{{end}}
+`),
+	"templates/urfave-cli-v2/version.tmpl": []byte(`package main
+
+// Version is the version number of {{.Name}}.
+const Version = "0.0.1"
+
+// build is the build identifier, injected at build time via
+// -ldflags "-X main.build=...".
+var build string
+`),
+	"templates/cobra/main.tmpl": []byte(`package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:     "{{.Name}}",
+	Version: Version,
+{{if not .HasSubCommand}}	Run: func(cmd *cobra.Command, args []string) {
+		// This is where the magic happens
+	},
+{{end}}}
+
+func main() {
+{{if .HasSubCommand}}	for _, cmd := range Commands {
+		rootCmd.AddCommand(cmd)
+	}
+{{if .WithSuggestions}}
+	if len(os.Args) > 1 {
+		if _, _, err := rootCmd.Find(os.Args[1:]); err != nil {
+			names := []string{}
+			for _, cmd := range Commands {
+				names = append(names, cmd.Name())
+			}
+			if name, ok := suggest(os.Args[1], names); ok {
+				fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\nDid you mean %q?\n", os.Args[1], name)
+				os.Exit(1)
+			}
+		}
+	}
+{{end}}{{end}}	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+`),
+	"templates/cobra/commands.tmpl": []byte(`package main
+
+import (
+{{if .WithCompletions}}	"fmt"
+	"os"
+
+{{end}}	"github.com/spf13/cobra"
+)
+
+var Commands = []*cobra.Command{
+{{range .SubCommands}}	{{.DefineName}},
+{{end}}{{if .WithCompletions}}	completionCmd,
+{{end}}}
+
+{{if .WithCompletions}}
+var completionCmd = &cobra.Command{
+	Use:    "completion [bash|zsh|fish|powershell]",
+	Short:  "Generate shell completion script",
+	Hidden: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		shell := ""
+		if len(args) > 0 {
+			shell = args[0]
+		}
+		script, err := completionScript(shell)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Print(script)
+	},
+}
+{{end}}
+
+{{range .SubCommands}}{{template "subcommand" .}}{{end}}
+{{define "subcommand"}}
+var {{.DefineName}} = &cobra.Command{
+	Use:   "{{.Name}}",
+{{if .Aliases}}	Aliases: []string{ {{range .Aliases}}"{{.}}", {{end}} },
+{{end}}	Short: "{{.Usage}}",
+{{if not .Subcommands}}	Run: func(cmd *cobra.Command, args []string) {
+		{{.FunctionName}}(cmd, args)
+	},
+{{end}}}
+
+func init() {
+{{$self := .}}{{range .Flags}}	{{$self.DefineName}}.Flags().String("{{.Name}}", "", "{{.Usage}}")
+{{end}}{{range .Subcommands}}	{{$self.DefineName}}.AddCommand({{.DefineName}})
+{{end}}}
+{{if not .Subcommands}}
+func {{.FunctionName}}(cmd *cobra.Command, args []string) {
+	// Add command action here...
+}
+{{end}}
+{{range .Subcommands}}{{template "subcommand" .}}{{end}}
+{{end}}
+`),
+	"templates/cobra/version.tmpl": []byte(`package main
+
+// Version is the version number of {{.Name}}.
+const Version = "0.0.1"
+
+// build is the build identifier, injected at build time via
+// -ldflags "-X main.build=...".
+var build string
+`),
+	"templates/stdlib/main.tmpl": []byte(`package main
+
+import (
+	"flag"
+{{if .HasSubCommand}}	"fmt"
+	"os"
+{{end}})
+
+func main() {
+	flag.Parse()
+
+{{if .HasSubCommand}}	switch flag.Arg(0) {
+{{range .SubCommands}}	case "{{.Name}}":
+		{{.FunctionName}}(flag.Args()[1:])
+{{end}}{{if .WithCompletions}}	case "completion":
+		shell := ""
+		if len(flag.Args()) > 1 {
+			shell = flag.Args()[1]
+		}
+		script, err := completionScript(shell)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Print(script)
+{{end}}	default:
+{{if .WithSuggestions}}		if name, ok := suggest(flag.Arg(0), []string{ {{range .SubCommands}}"{{.Name}}", {{end}} }); ok {
+			fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\nDid you mean %q?\n", flag.Arg(0), name)
+			os.Exit(1)
+		}
+{{end}}		fmt.Fprintf(os.Stderr, "{{.Name}}: unknown command %q\n", flag.Arg(0))
+		os.Exit(1)
+	}
+{{else}}	// This is where the magic happens
+{{end}}}
+`),
+	"templates/stdlib/commands.tmpl": []byte(`package main
+
+// The stdlib flag package has no notion of a command tree, so nested
+// subcommands are flattened to their top-level parent's dispatch
+// function here.
+
+{{range .SubCommands}}
+func {{.FunctionName}}(args []string) {
+	// Add command action here...
+}
+{{end}}
+`),
+	"templates/stdlib/version.tmpl": []byte(`package main
+
+// Version is the version number of {{.Name}}.
+const Version = "0.0.1"
+
+// build is the build identifier, injected at build time via
+// -ldflags "-X main.build=...".
+var build string
+`),
+}
+
+// Asset returns the contents of the named embedded template asset.
+func Asset(name string) ([]byte, error) {
+	data, ok := _bindata[name]
+	if !ok {
+		return nil, fmt.Errorf("asset not found: %s", name)
+	}
+	return data, nil
+}
@@ -9,22 +9,9 @@ import (
 	"text/template"
 )
 
-var versionTemplate = template.Must(ParseAsset("version", "templates/version.tmpl"))
-var mainTemplate = template.Must(ParseAsset("main", "templates/main.tmpl"))
-var commandsTemplate = template.Must(ParseAsset("main", "templates/commands.tmpl"))
 var readmeTemplate = template.Must(ParseAsset("readme", "templates/README.tmpl"))
 var changelogTemplate = template.Must(ParseAsset("changelog", "templates/CHANGELOG.tmpl"))
 
-var versionGo = Source{
-	Name:     "version.go",
-	Template: *versionTemplate,
-}
-
-var commandsGo = Source{
-	Name:     "commands.go",
-	Template: *commandsTemplate,
-}
-
 var readmeMd = Source{
 	Name:     "README.md",
 	Template: *readmeTemplate,
@@ -37,12 +24,30 @@ var changelogMd = Source{
 
 type Application struct {
 	Name, Author, Email, Username string
+	Framework                     string
 	HasSubCommand                 bool
 	SubCommands                   []SubCommand
+	WithSuggestions               bool
+	WithCompletions               bool
+	WithBadges                    bool
+	DockerImage                   string
 }
 
+// SubCommand describes one node of the generated application's command
+// tree. A SubCommand with a non-empty Subcommands slice is rendered as
+// a parent command with no Action of its own, mirroring the nested
+// cli.Command{Subcommands: ...} pattern from urfave/cli.
 type SubCommand struct {
 	Name, DefineName, FunctionName string
+	Aliases                        []string
+	Usage                          string
+	Flags                          []Flag
+	Subcommands                    []SubCommand
+}
+
+// Flag describes a single command-line flag attached to a SubCommand.
+type Flag struct {
+	Name, Usage string
 }
 
 func ParseAsset(name string, path string) (*template.Template, error) {
@@ -54,26 +59,32 @@ func ParseAsset(name string, path string) (*template.Template, error) {
 	return template.New(name).Parse(string(src))
 }
 
-func defineApplication(appName string, inputSubCommands []string, username string) Application {
-
-	hasSubCommand := false
-	if inputSubCommands[0] != "" {
-		hasSubCommand = true
-	}
+func defineApplication(cfg Config) Application {
 
 	gitUsername := GitConfig("user.name")
 
+	username := cfg.Username
 	if username == "" {
 		username = gitUsername
 	}
 
+	dockerImage := ""
+	if cfg.Docker {
+		dockerImage = username + "/" + cfg.AppName
+	}
+
 	return Application{
-		Name:          appName,
-		Author:        gitUsername,
-		Email:         GitConfig("user.email"),
-		Username:      username,
-		HasSubCommand: hasSubCommand,
-		SubCommands:   defineSubCommands(inputSubCommands),
+		Name:            cfg.AppName,
+		Author:          gitUsername,
+		Email:           GitConfig("user.email"),
+		Username:        username,
+		Framework:       cfg.Framework,
+		HasSubCommand:   len(cfg.SubCommands) > 0,
+		SubCommands:     cfg.SubCommands,
+		WithSuggestions: cfg.WithSuggestions,
+		WithCompletions: cfg.Completions,
+		WithBadges:      cfg.Badges,
+		DockerImage:     dockerImage,
 	}
 }
 
@@ -124,12 +135,20 @@ func showHelp() {
 func main() {
 
 	var (
-		flVersion     = flag.Bool([]string{"v", "-version"}, false, "Print version information and quit")
-		flHelp        = flag.Bool([]string{"h", "-help"}, false, "Print this message and quit")
-		flDebug       = flag.Bool([]string{"-debug"}, false, "Run as DEBUG mode")
-		flSubCommands = flag.String([]string{"s", "-subcommands"}, "", "Conma-seplated list of sub-commands to build")
-		flForce       = flag.Bool([]string{"f", "-force"}, false, "Overwrite application without prompting")
-		flUsername    = flag.String([]string{"u", "-username"}, "", "GitHub username")
+		flVersion         = flag.Bool([]string{"v", "-version"}, false, "Print version information and quit")
+		flHelp            = flag.Bool([]string{"h", "-help"}, false, "Print this message and quit")
+		flDebug           = flag.Bool([]string{"-debug"}, false, "Run as DEBUG mode")
+		flSubCommands     = flag.String([]string{"s", "-subcommands"}, "", "Conma-seplated list of sub-commands to build")
+		flCommandsSpec    = flag.String([]string{"-commands-spec"}, "", "Path to a YAML/JSON commands spec, or an inline \"parent:child,...\" DSL, describing a nested command tree")
+		flForce           = flag.Bool([]string{"f", "-force"}, false, "Overwrite application without prompting")
+		flUsername        = flag.String([]string{"u", "-username"}, "", "GitHub username")
+		flFramework       = flag.String([]string{"-framework"}, DefaultFramework, "CLI framework to scaffold against: "+strings.Join(SupportedFrameworks, ", "))
+		flWithSuggestions = flag.Bool([]string{"-with-suggestions"}, false, "Emit \"Did you mean?\" suggestions for unknown subcommands")
+		flCompletions     = flag.Bool([]string{"-completions"}, false, "Scaffold a hidden \"completion\" subcommand and docs/completions.md")
+		flReadmeBadges    = flag.Bool([]string{"-readme-badges"}, true, "Include release/license badges in README.md")
+		flDocker          = flag.Bool([]string{"-docker"}, false, "Scaffold a multi-stage Dockerfile")
+		flMakefile        = flag.Bool([]string{"-makefile"}, false, "Scaffold a Makefile with deps/vet/test/build targets (and a docker target with --docker)")
+		flCI              = flag.String([]string{"-ci"}, "none", "CI config to scaffold: github, drone, none")
 	)
 
 	flag.Parse()
@@ -149,82 +168,167 @@ func main() {
 		debug("Run as DEBUG mode")
 	}
 
-	inputSubCommands := strings.Split(*flSubCommands, ",")
-	debug("inputSubCommands:", inputSubCommands)
-
-	appName := flag.Arg(0)
-	debug("appName:", appName)
+	cfg := Config{
+		AppName:         flag.Arg(0),
+		Username:        *flUsername,
+		Framework:       *flFramework,
+		WithSuggestions: *flWithSuggestions,
+		Completions:     *flCompletions,
+		Badges:          *flReadmeBadges,
+		Docker:          *flDocker,
+		Makefile:        *flMakefile,
+		CI:              *flCI,
+		Force:           *flForce,
+	}
 
-	if appName == "" {
-		fmt.Fprintf(os.Stderr, "Application name must not be blank\n")
-		os.Exit(1)
+	if *flCommandsSpec != "" {
+		subCommands, err := defineSubCommandsFromSpec(*flCommandsSpec)
+		assert(err)
+		cfg.SubCommands = subCommands
+	} else {
+		cfg.SubCommands = defineSubCommands(strings.Split(*flSubCommands, ","))
 	}
+	debug("subCommands:", cfg.SubCommands)
+	debug("appName:", cfg.AppName)
 
-	if _, err := os.Stat(appName); err == nil && *flForce {
-		err = os.RemoveAll(appName)
+	if cfg.AppName == "" && isInteractive() {
+		var err error
+		cfg, err = runInteractive()
 		assert(err)
 	}
 
-	if _, err := os.Stat(appName); err == nil {
-		fmt.Fprintf(os.Stderr, "%s is already exists, overwrite it? [Y/n]: ", appName)
+	assert(runNonInteractive(cfg))
+
+	os.Exit(0)
+}
+
+// runNonInteractive generates an application from a fully-populated
+// Config, prompting only to confirm overwriting an existing directory.
+func runNonInteractive(cfg Config) error {
+
+	if cfg.AppName == "" {
+		return fmt.Errorf("Application name must not be blank")
+	}
+
+	generator, err := NewGenerator(cfg.Framework)
+	if err != nil {
+		return err
+	}
+
+	ciSource, err := ciConfigSource(cfg.CI)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(cfg.AppName); err == nil && cfg.Force {
+		if err := os.RemoveAll(cfg.AppName); err != nil {
+			return err
+		}
+	}
+
+	if _, err := os.Stat(cfg.AppName); err == nil {
+		fmt.Fprintf(os.Stderr, "%s is already exists, overwrite it? [Y/n]: ", cfg.AppName)
 		var ans string
-		_, err := fmt.Scanf("%s", &ans)
-		assert(err)
+		if _, err := fmt.Scanf("%s", &ans); err != nil {
+			return err
+		}
 
 		if ans == "Y" {
-			err = os.RemoveAll(appName)
-			assert(err)
+			if err := os.RemoveAll(cfg.AppName); err != nil {
+				return err
+			}
 		} else {
-			os.Exit(0)
+			return nil
 		}
 	}
 
 	// Create directory
-	err := os.Mkdir(appName, 0766)
-	assert(err)
+	if err := os.Mkdir(cfg.AppName, 0766); err != nil {
+		return err
+	}
 
-	application := defineApplication(appName, inputSubCommands, *flUsername)
+	application := defineApplication(cfg)
 
 	// Create README.md
-	err = readmeMd.generate(appName, application)
-	assert(err)
+	if err := readmeMd.generate(cfg.AppName, application); err != nil {
+		return err
+	}
 
 	// Create CHANGELOG.md
-	err = changelogMd.generate(appName, application)
-	assert(err)
+	if err := changelogMd.generate(cfg.AppName, application); err != nil {
+		return err
+	}
 
-	// Create verion.go
-	err = versionGo.generate(appName, application)
-	assert(err)
+	// Create <appName>.go, version.go and, if needed, commands.go
+	if err := generator.Generate(cfg.AppName, application); err != nil {
+		return err
+	}
 
-	// Create <appName>.go
-	mainGo := Source{
-		Name:     appName + ".go",
-		Template: *mainTemplate,
+	if cfg.Docker {
+		dockerfile, err := dockerfileSource()
+		if err != nil {
+			return err
+		}
+		if err := dockerfile.generate(cfg.AppName, application); err != nil {
+			return err
+		}
 	}
-	mainGo.generate(appName, application)
-	assert(err)
 
-	// Create commands.go
-	if application.HasSubCommand {
-		commandsGo.generate(appName, application)
+	if cfg.Makefile {
+		makefile, err := makefileSource()
+		if err != nil {
+			return err
+		}
+		if err := makefile.generate(cfg.AppName, application); err != nil {
+			return err
+		}
 	}
 
-	err = GoFmt(appName)
-	assert(err)
+	if ciSource.Name != "" {
+		if err := ciSource.generate(cfg.AppName, application); err != nil {
+			return err
+		}
+	}
 
-	os.Exit(0)
+	if cfg.Completions && application.HasSubCommand {
+		completionsDoc, err := completionsDocSource()
+		if err != nil {
+			return err
+		}
+		if err := completionsDoc.generate(cfg.AppName, application); err != nil {
+			return err
+		}
+	}
+
+	return GoFmt(cfg.AppName)
 }
 
 const helpText = `Usage: cli-init [options] [application]
 
 cli-init is the easy way to start building command-line app.
 
+Run without an [application] argument in a terminal to be walked
+through an interactive prompt instead.
+
 Options:
 
   -s="", --subcommands=""    Comma-separated list of sub-commands to build
+  --commands-spec=""         Path to a YAML/JSON commands spec, or an inline
+                              "parent:child,..." DSL, describing a nested
+                              command tree (overrides --subcommands)
   -u="", --username=""       GitHub username
-  -f, --force                Overwrite application without prompting 
+  --framework="urfave-cli-v1" CLI framework to scaffold against: mflag,
+                              urfave-cli-v1, urfave-cli-v2, cobra, stdlib
+  --with-suggestions         Emit "Did you mean?" suggestions for unknown
+                              subcommands
+  --completions              Scaffold a hidden "completion" subcommand and
+                              docs/completions.md (requires subcommands)
+  --readme-badges=true       Include release/license badges in README.md
+  --docker                   Scaffold a multi-stage Dockerfile
+  --makefile                 Scaffold a Makefile with deps/vet/test/build targets
+                              (and a docker target with --docker)
+  --ci="none"                CI config to scaffold: github, drone, none
+  -f, --force                Overwrite application without prompting
   -h, --help                 Print this message and quit
   -v, --version              Print version information and quit
   --debug=false              Run as DEBUG mode
@@ -233,4 +337,7 @@ Example:
 
   $ cli-init todo
   $ cli-init -s add,list,delete todo
+  $ cli-init --commands-spec template:add,template:remove,add,complete todo
+  $ cli-init --framework cobra todo
+  $ cli-init --docker --makefile --ci github todo
 `
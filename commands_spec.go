@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// specSubCommand is the YAML/JSON representation of a SubCommand read
+// from a --commands-spec file. It is converted into a SubCommand tree
+// by toSubCommands.
+type specSubCommand struct {
+	Name        string           `yaml:"name" json:"name"`
+	Aliases     []string         `yaml:"aliases" json:"aliases"`
+	Usage       string           `yaml:"usage" json:"usage"`
+	Flags       []Flag           `yaml:"flags" json:"flags"`
+	Subcommands []specSubCommand `yaml:"subcommands" json:"subcommands"`
+}
+
+// defineSubCommandsFromSpec builds a SubCommand tree from a
+// --commands-spec value, which is either a path to a YAML/JSON file or
+// an inline mini-DSL string such as "template:add,template:remove,add".
+func defineSubCommandsFromSpec(spec string) ([]SubCommand, error) {
+	if data, err := ioutil.ReadFile(spec); err == nil {
+		specCommands, err := parseCommandsSpecFile(spec, data)
+		if err != nil {
+			return nil, err
+		}
+		return toSubCommands(specCommands)
+	}
+
+	return parseCommandsSpecDSL(spec)
+}
+
+// parseCommandsSpecFile decodes a YAML or JSON commands spec based on
+// the file's extension.
+func parseCommandsSpecFile(path string, data []byte) ([]specSubCommand, error) {
+	var specCommands []specSubCommand
+
+	if strings.HasSuffix(path, ".json") {
+		if err := json.Unmarshal(data, &specCommands); err != nil {
+			return nil, err
+		}
+		return specCommands, nil
+	}
+
+	if err := yaml.Unmarshal(data, &specCommands); err != nil {
+		return nil, err
+	}
+	return specCommands, nil
+}
+
+func toSubCommands(specCommands []specSubCommand) ([]SubCommand, error) {
+	var subCommands []SubCommand
+
+	for _, sc := range specCommands {
+		if sc.Name == "" {
+			return nil, fmt.Errorf("commands spec: subcommand name must not be blank")
+		}
+
+		children, err := toSubCommands(sc.Subcommands)
+		if err != nil {
+			return nil, err
+		}
+
+		subCommands = append(subCommands, SubCommand{
+			Name:         sc.Name,
+			DefineName:   "command" + ToUpperFirst(sc.Name),
+			FunctionName: "do" + ToUpperFirst(sc.Name),
+			Aliases:      sc.Aliases,
+			Usage:        sc.Usage,
+			Flags:        sc.Flags,
+			Subcommands:  children,
+		})
+	}
+
+	return subCommands, nil
+}
+
+// parseCommandsSpecDSL parses the mini DSL accepted by --commands-spec,
+// e.g. "template:add,template:remove,add,complete". A "parent:child"
+// token nests child under parent; a bare token is a top-level command.
+// Top-level commands keep the order of their first mention, whether
+// as a bare token or as a parent.
+func parseCommandsSpecDSL(spec string) ([]SubCommand, error) {
+	var subCommands []SubCommand
+	index := make(map[string]int)
+
+	findOrCreate := func(name string) (int, error) {
+		if name == "" {
+			return 0, fmt.Errorf("commands spec: subcommand name must not be blank")
+		}
+		if i, ok := index[name]; ok {
+			return i, nil
+		}
+		subCommands = append(subCommands, SubCommand{
+			Name:         name,
+			DefineName:   "command" + ToUpperFirst(name),
+			FunctionName: "do" + ToUpperFirst(name),
+		})
+		i := len(subCommands) - 1
+		index[name] = i
+		return i, nil
+	}
+
+	for _, token := range strings.Split(spec, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		parts := strings.SplitN(token, ":", 2)
+		if len(parts) == 1 {
+			if _, err := findOrCreate(parts[0]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		parentIndex, err := findOrCreate(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		child := parts[1]
+		if child == "" {
+			return nil, fmt.Errorf("commands spec: subcommand name must not be blank")
+		}
+
+		subCommands[parentIndex].Subcommands = append(subCommands[parentIndex].Subcommands, SubCommand{
+			Name:         child,
+			DefineName:   "command" + ToUpperFirst(parts[0]) + ToUpperFirst(child),
+			FunctionName: "do" + ToUpperFirst(parts[0]) + ToUpperFirst(child),
+		})
+	}
+
+	return subCommands, nil
+}
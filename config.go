@@ -0,0 +1,18 @@
+package main
+
+// Config collects everything needed to generate an application,
+// whether gathered from flags (runNonInteractive) or from the
+// interactive prompt (runInteractive).
+type Config struct {
+	AppName         string
+	SubCommands     []SubCommand
+	Username        string
+	Framework       string
+	WithSuggestions bool
+	Completions     bool
+	Badges          bool
+	Docker          bool
+	Makefile        bool
+	CI              string
+	Force           bool
+}
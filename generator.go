@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"path"
+)
+
+// SupportedFrameworks lists the CLI framework backends cli-init can
+// target with -framework/--framework.
+var SupportedFrameworks = []string{"mflag", "urfave-cli-v1", "urfave-cli-v2", "cobra", "stdlib"}
+
+// DefaultFramework is used when -framework is not given.
+const DefaultFramework = "urfave-cli-v1"
+
+// Generator produces the framework-specific source files (<appName>.go,
+// version.go and, if the application has subcommands, commands.go) for
+// a generated application.
+type Generator interface {
+	Generate(appName string, application Application) error
+}
+
+// frameworkGenerator is the Generator for a single templates/<framework>
+// asset set.
+type frameworkGenerator struct {
+	framework string
+}
+
+// NewGenerator returns the Generator for the given framework name.
+func NewGenerator(framework string) (Generator, error) {
+	for _, f := range SupportedFrameworks {
+		if f == framework {
+			return &frameworkGenerator{framework: framework}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown framework %q, must be one of %v", framework, SupportedFrameworks)
+}
+
+func (g *frameworkGenerator) asset(name string) string {
+	return path.Join("templates", g.framework, name)
+}
+
+func (g *frameworkGenerator) Generate(appName string, application Application) error {
+	versionTemplate, err := ParseAsset("version", g.asset("version.tmpl"))
+	if err != nil {
+		return err
+	}
+	versionGo := Source{Name: "version.go", Template: *versionTemplate}
+	if err := versionGo.generate(appName, application); err != nil {
+		return err
+	}
+
+	mainTemplate, err := ParseAsset("main", g.asset("main.tmpl"))
+	if err != nil {
+		return err
+	}
+	mainGo := Source{Name: appName + ".go", Template: *mainTemplate}
+	if err := mainGo.generate(appName, application); err != nil {
+		return err
+	}
+
+	if !application.HasSubCommand {
+		return nil
+	}
+
+	commandsTemplate, err := ParseAsset("commands", g.asset("commands.tmpl"))
+	if err != nil {
+		return err
+	}
+	commandsGo := Source{Name: "commands.go", Template: *commandsTemplate}
+	if err := commandsGo.generate(appName, application); err != nil {
+		return err
+	}
+
+	if application.WithSuggestions {
+		suggestionsTemplate, err := ParseAsset("suggestions", "templates/suggestions.tmpl")
+		if err != nil {
+			return err
+		}
+		suggestionsGo := Source{Name: "suggestions.go", Template: *suggestionsTemplate}
+		if err := suggestionsGo.generate(appName, application); err != nil {
+			return err
+		}
+
+		suggestionsTestTemplate, err := ParseAsset("suggestions_test", "templates/suggestions_test.tmpl")
+		if err != nil {
+			return err
+		}
+		suggestionsTestGo := Source{Name: "suggestions_test.go", Template: *suggestionsTestTemplate}
+		if err := suggestionsTestGo.generate(appName, application); err != nil {
+			return err
+		}
+	}
+
+	if !application.WithCompletions {
+		return nil
+	}
+
+	completionTemplate, err := ParseAsset("completion", "templates/completion.tmpl")
+	if err != nil {
+		return err
+	}
+	completionGo := Source{Name: "completion.go", Template: *completionTemplate}
+	return completionGo.generate(appName, application)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+// isInteractive reports whether stdin is a terminal, i.e. whether it
+// makes sense to fall back to runInteractive when no application name
+// was given on the command line.
+func isInteractive() bool {
+	return isatty.IsTerminal(os.Stdin.Fd())
+}
+
+// runInteractive walks the user through building a Config via a series
+// of prompts, replacing the terse "Application name must not be blank"
+// error with a discoverable wizard. It converges on the same Config the
+// flag-driven path builds, so both feed the same runNonInteractive.
+func runInteractive() (Config, error) {
+	reader := bufio.NewReader(os.Stdin)
+
+	appName := promptString(reader, "Application name", "")
+	if appName == "" {
+		return Config{}, fmt.Errorf("Application name must not be blank")
+	}
+
+	username := promptString(reader, "GitHub username", GitConfig("user.name"))
+	framework := promptString(reader, fmt.Sprintf("CLI framework (%s)", strings.Join(SupportedFrameworks, ", ")), DefaultFramework)
+
+	subCommands := promptSubCommands(reader)
+
+	withSuggestions := promptBool(reader, "Emit \"Did you mean?\" suggestions for unknown subcommands", false)
+	completions := promptBool(reader, "Scaffold a hidden \"completion\" subcommand", false)
+	badges := promptBool(reader, "Include release/license badges in README.md", true)
+	docker := promptBool(reader, "Scaffold a Dockerfile", false)
+	makefile := promptBool(reader, "Scaffold a Makefile", false)
+	ci := promptString(reader, fmt.Sprintf("CI config (%s)", strings.Join(SupportedCIs, ", ")), "none")
+
+	return Config{
+		AppName:         appName,
+		SubCommands:     subCommands,
+		Username:        username,
+		Framework:       framework,
+		WithSuggestions: withSuggestions,
+		Completions:     completions,
+		Badges:          badges,
+		Docker:          docker,
+		Makefile:        makefile,
+		CI:              ci,
+	}, nil
+}
+
+// promptSubCommands walks the user through building up the application's
+// subcommand list one at a time. Typing a name adds it (prompting for its
+// usage string); prefixing a previously entered name with "-" removes it;
+// a blank line finishes the list.
+func promptSubCommands(reader *bufio.Reader) []SubCommand {
+	fmt.Fprintln(os.Stderr, "Add subcommands one at a time (-name to remove), blank name to finish:")
+
+	var subCommands []SubCommand
+	for {
+		name := promptString(reader, "  subcommand name", "")
+		if name == "" {
+			break
+		}
+
+		if strings.HasPrefix(name, "-") {
+			remove := strings.TrimPrefix(name, "-")
+			for i, sub := range subCommands {
+				if sub.Name == remove {
+					subCommands = append(subCommands[:i], subCommands[i+1:]...)
+					break
+				}
+			}
+			continue
+		}
+
+		usage := promptString(reader, "  usage", "")
+		subCommands = append(subCommands, SubCommand{
+			Name:         name,
+			DefineName:   "command" + ToUpperFirst(name),
+			FunctionName: "do" + ToUpperFirst(name),
+			Usage:        usage,
+		})
+	}
+
+	return subCommands
+}
+
+// promptString prints label along with def (if any) and returns the
+// trimmed line the user typed, or def if they just pressed enter.
+func promptString(reader *bufio.Reader, label, def string) string {
+	if def != "" {
+		fmt.Fprintf(os.Stderr, "%s [%s]: ", label, def)
+	} else {
+		fmt.Fprintf(os.Stderr, "%s: ", label)
+	}
+
+	line, _ := reader.ReadString('\n')
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return def
+	}
+	return line
+}
+
+// promptBool prints a y/n prompt and returns def if the user just
+// presses enter.
+func promptBool(reader *bufio.Reader, label string, def bool) bool {
+	hint := "y/N"
+	if def {
+		hint = "Y/n"
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [%s]: ", label, hint)
+
+	line, _ := reader.ReadString('\n')
+	line = strings.TrimSpace(strings.ToLower(line))
+	if line == "" {
+		return def
+	}
+	return line == "y" || line == "yes"
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// SupportedCIs lists the --ci values cli-init understands.
+var SupportedCIs = []string{"github", "drone", "none"}
+
+// dockerfileSource returns the Source that renders templates/Dockerfile.tmpl
+// into the generated application's Dockerfile.
+func dockerfileSource() (Source, error) {
+	t, err := ParseAsset("dockerfile", "templates/Dockerfile.tmpl")
+	if err != nil {
+		return Source{}, err
+	}
+	return Source{Name: "Dockerfile", Template: *t}, nil
+}
+
+// makefileSource returns the Source that renders templates/Makefile.tmpl
+// into the generated application's Makefile.
+func makefileSource() (Source, error) {
+	t, err := ParseAsset("makefile", "templates/Makefile.tmpl")
+	if err != nil {
+		return Source{}, err
+	}
+	return Source{Name: "Makefile", Template: *t}, nil
+}
+
+// completionsDocSource returns the Source that renders
+// templates/docs/completions.tmpl into the generated application's
+// docs/completions.md.
+func completionsDocSource() (Source, error) {
+	t, err := ParseAsset("completionsDoc", "templates/docs/completions.tmpl")
+	if err != nil {
+		return Source{}, err
+	}
+	return Source{Name: filepath.Join("docs", "completions.md"), Template: *t}, nil
+}
+
+// ciConfigSource returns the Source that renders the CI config for the
+// given --ci value, or the zero Source if ci is "none".
+func ciConfigSource(ci string) (Source, error) {
+	switch ci {
+	case "none":
+		return Source{}, nil
+	case "github":
+		t, err := ParseAsset("ciConfig", "templates/ci/github.tmpl")
+		if err != nil {
+			return Source{}, err
+		}
+		return Source{Name: filepath.Join(".github", "workflows", "ci.yml"), Template: *t}, nil
+	case "drone":
+		t, err := ParseAsset("ciConfig", "templates/ci/drone.tmpl")
+		if err != nil {
+			return Source{}, err
+		}
+		return Source{Name: ".drone.yml", Template: *t}, nil
+	default:
+		return Source{}, fmt.Errorf("unknown ci %q, must be one of %v", ci, SupportedCIs)
+	}
+}
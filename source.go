@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// Source represents a single file to be rendered from a template into
+// the generated application directory.
+type Source struct {
+	Name     string
+	Template template.Template
+}
+
+// generate renders the Source's template for the given application and
+// writes the result to <dir>/<Name>. Name may contain subdirectories
+// (e.g. ".github/workflows/ci.yml"), which are created as needed.
+func (s *Source) generate(dir string, application Application) error {
+	path := filepath.Join(dir, s.Name)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0766); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return s.Template.Execute(f, application)
+}
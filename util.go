@@ -0,0 +1,22 @@
+package main
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// GitConfig returns the value of the given git config key for the
+// current user, or an empty string if git is unavailable or the key
+// is unset.
+func GitConfig(key string) string {
+	out, err := exec.Command("git", "config", "--get", key).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// GoFmt runs `gofmt -w` over the generated application directory.
+func GoFmt(dir string) error {
+	return exec.Command("gofmt", "-w", dir).Run()
+}
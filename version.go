@@ -0,0 +1,4 @@
+package main
+
+// Version is the version number of cli-init itself.
+const Version = "0.1.0"